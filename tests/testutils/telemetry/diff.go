@@ -0,0 +1,171 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-test/deep"
+)
+
+// Diff is the structured result of comparing a receiver ResourceLogs against an expected subset via Diff or
+// ContainsAll, recording everything in the expected ResourceLogs that could not be matched.
+type Diff struct {
+	MissingResources []Resource
+	MissingScopes    []InstrumentationScope
+	MissingLogs      []LogDiff
+}
+
+// LogDiff describes an expected Log that was not found in the received ResourceLogs, along with the closest
+// candidate considered for a match (the received Log in the same InstrumentationScope with the fewest field-level
+// differences from expected, per github.com/go-test/deep) and those differences. Closest is nil when the matching
+// InstrumentationScope had no logs at all.
+type LogDiff struct {
+	Expected Log
+	Closest  *Log
+	Fields   []string
+}
+
+// Empty reports whether the Diff found no missing resources, scopes, or logs, i.e. the expected ResourceLogs was
+// fully contained in the one being compared against.
+func (d Diff) Empty() bool {
+	return len(d.MissingResources) == 0 && len(d.MissingScopes) == 0 && len(d.MissingLogs) == 0
+}
+
+// String renders the Diff as a human-readable, multi-line report suitable for a test failure message.
+func (d Diff) String() string {
+	var b strings.Builder
+	for _, resource := range d.MissingResources {
+		fmt.Fprintf(&b, "missing resource:\n%s", indent(resource.String()))
+	}
+	for _, scope := range d.MissingScopes {
+		fmt.Fprintf(&b, "missing instrumentation scope:\n%s", indent(scope.String()))
+	}
+	for _, logDiff := range d.MissingLogs {
+		if logDiff.Closest == nil {
+			fmt.Fprintf(&b, "missing log, no candidate logs in matching scope:\n%s", indent(logDiff.Expected.String()))
+			continue
+		}
+		fmt.Fprintf(&b, "missing log, closest match differs:\n")
+		for _, field := range logDiff.Fields {
+			fmt.Fprintf(&b, "  %s\n", field)
+		}
+	}
+	return b.String()
+}
+
+func indent(s string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "  " + line
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Diff determines everything in expected that is not found in the receiver ResourceLogs, per the same RelaxedEquals
+// semantics as ContainsAll. For each expected Log that can't be matched, it reports the closest candidate in the
+// same InstrumentationScope (fewest field-level differences) so failures point at the specific attribute or field
+// that differs, instead of dumping the entire scope's logs.
+func (resourceLogs ResourceLogs) Diff(expected ResourceLogs) Diff {
+	var diff Diff
+
+	for _, expectedResourceLog := range expected.ResourceLogs {
+		resourceLog, ok := findResourceLog(resourceLogs.ResourceLogs, expectedResourceLog.Resource)
+		if !ok {
+			diff.MissingResources = append(diff.MissingResources, expectedResourceLog.Resource)
+			continue
+		}
+		for _, expectedScopeLogs := range expectedResourceLog.ScopeLogs {
+			scopeLogs, ok := findScopeLogs(resourceLog.ScopeLogs, expectedScopeLogs.Scope)
+			if !ok {
+				diff.MissingScopes = append(diff.MissingScopes, expectedScopeLogs.Scope)
+				continue
+			}
+			for _, expectedLog := range expectedScopeLogs.Logs {
+				if logMatches(scopeLogs.Logs, expectedLog) {
+					continue
+				}
+				diff.MissingLogs = append(diff.MissingLogs, closestLogDiff(expectedLog, scopeLogs.Logs))
+			}
+		}
+	}
+
+	return diff
+}
+
+func findResourceLog(resourceLogs []ResourceLog, resource Resource) (ResourceLog, bool) {
+	for _, resourceLog := range resourceLogs {
+		if resourceLog.Resource.Equals(resource) {
+			return resourceLog, true
+		}
+	}
+	return ResourceLog{}, false
+}
+
+func findScopeLogs(scopeLogs []ScopeLogs, scope InstrumentationScope) (ScopeLogs, bool) {
+	for _, sl := range scopeLogs {
+		if sl.Scope.Equals(scope) {
+			return sl, true
+		}
+	}
+	return ScopeLogs{}, false
+}
+
+func logMatches(logs []Log, expected Log) bool {
+	for _, log := range logs {
+		if expected.RelaxedEquals(log) {
+			return true
+		}
+	}
+	return false
+}
+
+// closestLogDiff finds the candidate in candidates with the fewest field-level differences from expected, per
+// deep.Equal, and returns it alongside those differences.
+func closestLogDiff(expected Log, candidates []Log) LogDiff {
+	logDiff := LogDiff{Expected: expected}
+	for i := range candidates {
+		fields := deep.Equal(expected, relaxedDiffCandidate(expected, candidates[i]))
+		if logDiff.Closest == nil || len(fields) < len(logDiff.Fields) {
+			candidate := candidates[i]
+			logDiff.Closest = &candidate
+			logDiff.Fields = fields
+		}
+	}
+	return logDiff
+}
+
+// relaxedDiffCandidate returns a copy of candidate with every field RelaxedEquals doesn't consider (an unset field
+// in expected, and the timestamps, which RelaxedEquals never compares) overwritten with expected's own value, so
+// diffing it against expected via deep.Equal surfaces only the fields that actually caused the match to fail.
+func relaxedDiffCandidate(expected, candidate Log) Log {
+	masked := candidate
+	masked.ObservedTimestamp = expected.ObservedTimestamp
+	masked.Timestamp = expected.Timestamp
+	if expected.Body == nil {
+		masked.Body = nil
+	}
+	if expected.SeverityText == "" {
+		masked.SeverityText = ""
+	}
+	if expected.Severity == nil {
+		masked.Severity = nil
+	}
+	if expected.Attributes == nil {
+		masked.Attributes = nil
+	}
+	return masked
+}
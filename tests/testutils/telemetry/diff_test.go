@@ -0,0 +1,52 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestDiff_ClosestLogDiffOnlyReportsRelevantFields confirms that when an expected Log only sets Attributes, a
+// mismatch on a single attribute value is the only field reported, even though the candidate also sets a Timestamp
+// and SeverityText that expected leaves unset, which RelaxedEquals ignores.
+func TestDiff_ClosestLogDiffOnlyReportsRelevantFields(t *testing.T) {
+	expectedAttrs := map[string]any{"host.name": "a"}
+	candidateAttrs := map[string]any{"host.name": "b"}
+
+	expected := ResourceLogs{ResourceLogs: []ResourceLog{{
+		ScopeLogs: []ScopeLogs{{Logs: []Log{{Attributes: &expectedAttrs}}}},
+	}}}
+	got := ResourceLogs{ResourceLogs: []ResourceLog{{
+		ScopeLogs: []ScopeLogs{{Logs: []Log{{
+			Attributes:   &candidateAttrs,
+			Timestamp:    time.Now(),
+			SeverityText: "INFO",
+		}}}},
+	}}}
+
+	diff := got.Diff(expected)
+	if diff.Empty() {
+		t.Fatal("expected a diff for the mismatched attribute")
+	}
+	if len(diff.MissingLogs) != 1 {
+		t.Fatalf("expected exactly 1 missing log, got %d", len(diff.MissingLogs))
+	}
+	fields := diff.MissingLogs[0].Fields
+	if len(fields) != 1 || !strings.Contains(fields[0], "host.name") {
+		t.Fatalf("expected a single host.name field diff, got %+v", fields)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+)
+
+// interpolationPattern matches ${VAR}, ${VAR:-default}, ${VAR-default}, ${VAR:?error}, and ${VAR?error}, following
+// the semantics compose-go uses for docker-compose files.
+var interpolationPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?:(:?[-?])([^}]*))?\}`)
+
+// resolveFixture reads the fixture at path, resolves ${VAR} interpolation, top-level includes, and per-resource_log
+// extends, and returns the resulting document as YAML bytes ready for schema validation and unmarshalling.
+//
+// includes is a top-level list of fixture paths, relative to path's directory, whose resource_logs are prepended to
+// this fixture's own. extends is a per-resource_log {file, resource} reference to a named resource_log (see
+// ResourceLog.Name) in another fixture: scalars in the extending (child) resource_log override the extended
+// (parent) one, scope_logs and logs slices append, and attribute maps deep-merge.
+//
+// visited guards against include/extends cycles; callers should pass an empty map.
+func resolveFixture(path string, opts LoadOptions, visited map[string]bool) ([]byte, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if visited[absPath] {
+		return nil, fmt.Errorf("%s: cyclical include/extends", path)
+	}
+	// Branch off a copy so that siblings sharing a common ancestor (e.g. two extends of the same base fixture, or
+	// an includes and a sibling extends of the same file) don't see each other's visits: only the current
+	// ancestry path, not everything touched anywhere in the load, should trip the cycle check.
+	branch := make(map[string]bool, len(visited)+1)
+	for k, v := range visited {
+		branch[k] = v
+	}
+	branch[absPath] = true
+	visited = branch
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	interpolated, err := interpolateVariables(raw, opts.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var doc any
+	if err = yaml.Unmarshal(interpolated, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	normalized, ok := normalizeYAMLValue(doc).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: fixture root must be a mapping", path)
+	}
+
+	dir := filepath.Dir(path)
+	resourceLogs, _ := normalized["resource_logs"].([]any)
+
+	if includes, ok := normalized["includes"].([]any); ok {
+		for _, include := range includes {
+			includePath, ok := include.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: includes entries must be strings", path)
+			}
+			includedLogs, err := resolveIncludedResourceLogs(filepath.Join(dir, includePath), opts, visited)
+			if err != nil {
+				return nil, err
+			}
+			resourceLogs = append(includedLogs, resourceLogs...)
+		}
+		delete(normalized, "includes")
+	}
+
+	for i, entry := range resourceLogs {
+		resourceLog, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		extends, ok := resourceLog["extends"].(map[string]any)
+		if !ok {
+			continue
+		}
+		merged, err := resolveExtends(dir, extends, resourceLog, opts, visited)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		resourceLogs[i] = merged
+	}
+	normalized["resource_logs"] = resourceLogs
+
+	return yaml.Marshal(normalized)
+}
+
+// resolveIncludedResourceLogs resolves the fixture at path and returns its resource_logs entries for merging into
+// an including fixture.
+func resolveIncludedResourceLogs(path string, opts LoadOptions, visited map[string]bool) ([]any, error) {
+	resolved, err := resolveFixture(path, opts, visited)
+	if err != nil {
+		return nil, err
+	}
+	var doc any
+	if err = yaml.Unmarshal(resolved, &doc); err != nil {
+		return nil, err
+	}
+	// yaml.Unmarshal decodes nested mappings as map[interface{}]interface{} regardless of the target type, so the
+	// resource_log entries below doc["resource_logs"] need the same normalization applied when the fixture was
+	// first parsed, or later map[string]any type assertions (e.g. matching extends.resource by name) silently fail.
+	normalized, ok := normalizeYAMLValue(doc).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: fixture root must be a mapping", path)
+	}
+	resourceLogs, _ := normalized["resource_logs"].([]any)
+	return resourceLogs, nil
+}
+
+// resolveExtends resolves an extends: {file, resource} reference relative to dir and deep-merges child (the
+// extending resource_log, with its extends key already consumed by the caller) onto the named resource_log in file.
+func resolveExtends(dir string, extends map[string]any, child map[string]any, opts LoadOptions, visited map[string]bool) (map[string]any, error) {
+	file, _ := extends["file"].(string)
+	resourceName, _ := extends["resource"].(string)
+	if file == "" || resourceName == "" {
+		return nil, fmt.Errorf("extends requires both file and resource")
+	}
+
+	parentResourceLogs, err := resolveIncludedResourceLogs(filepath.Join(dir, file), opts, visited)
+	if err != nil {
+		return nil, err
+	}
+	var parent map[string]any
+	for _, entry := range parentResourceLogs {
+		candidate, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := candidate["name"].(string); name == resourceName {
+			parent = candidate
+			break
+		}
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("extends: resource %q not found in %s", resourceName, file)
+	}
+
+	delete(child, "extends")
+	merged, ok := deepMergeFixtureValue(parent, child).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("extends: resource %q in %s did not merge to a mapping", resourceName, file)
+	}
+	return merged, nil
+}
+
+// deepMergeFixtureValue merges child onto parent: child's scalars override parent's, the scope_logs and logs
+// slices append (parent entries first, then child's), attribute maps deep-merge key by key, and any other key
+// present only in one of the two is passed through unchanged.
+func deepMergeFixtureValue(parent, child any) any {
+	childMap, ok := child.(map[string]any)
+	if !ok {
+		return child
+	}
+	parentMap, ok := parent.(map[string]any)
+	if !ok {
+		return childMap
+	}
+
+	merged := make(map[string]any, len(parentMap)+len(childMap))
+	for k, v := range parentMap {
+		merged[k] = v
+	}
+	for k, v := range childMap {
+		switch k {
+		case "scope_logs", "logs":
+			merged[k] = appendFixtureSlices(parentMap[k], v)
+		default:
+			merged[k] = deepMergeFixtureValue(parentMap[k], v)
+		}
+	}
+	return merged
+}
+
+// appendFixtureSlices concatenates parent and child slice values, tolerating either being absent or not a slice.
+func appendFixtureSlices(parent, child any) []any {
+	var merged []any
+	if slice, ok := parent.([]any); ok {
+		merged = append(merged, slice...)
+	}
+	if slice, ok := child.([]any); ok {
+		merged = append(merged, slice...)
+	}
+	return merged
+}
+
+// interpolateVariables expands ${VAR}, ${VAR:-default}/${VAR-default}, and ${VAR:?error}/${VAR?error} references in
+// by against vars, falling back to the process environment. ${VAR:-default} and ${VAR:?error} treat an empty value
+// as unset; the unprefixed ${VAR-default} and ${VAR?error} forms only treat a missing variable as unset.
+func interpolateVariables(by []byte, vars map[string]string) ([]byte, error) {
+	var resolveErr error
+	resolved := interpolationPattern.ReplaceAllFunc(by, func(match []byte) []byte {
+		groups := interpolationPattern.FindSubmatch(match)
+		name := string(groups[1])
+		op := string(groups[2])
+		arg := string(groups[3])
+
+		value, set := vars[name]
+		if !set {
+			value, set = os.LookupEnv(name)
+		}
+		// A leading ":" (":-", ":?") additionally treats an empty value as unset; a bare "-"/"?" only cares
+		// whether the variable was set at all.
+		if set && op != "" && op[0] == ':' && value == "" {
+			set = false
+		}
+
+		switch {
+		case op == "":
+			if !set {
+				resolveErr = fmt.Errorf("variable %q is not set", name)
+			}
+		case op[len(op)-1] == '-':
+			if !set {
+				value = arg
+			}
+		case op[len(op)-1] == '?':
+			if !set {
+				resolveErr = fmt.Errorf("variable %q is not set: %s", name, arg)
+			}
+		}
+		if resolveErr != nil {
+			return match
+		}
+		return []byte(value)
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return resolved, nil
+}
@@ -0,0 +1,228 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInterpolateVariables(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		vars    map[string]string
+		env     map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "plain variable resolved from vars",
+			in:   "value: ${FOO}",
+			vars: map[string]string{"FOO": "bar"},
+			want: "value: bar",
+		},
+		{
+			name:    "plain variable missing is an error",
+			in:      "value: ${FOO}",
+			wantErr: true,
+		},
+		{
+			name: "dash-default used only when unset",
+			in:   "value: ${FOO-default}",
+			env:  map[string]string{"FOO": ""},
+			want: "value: ",
+		},
+		{
+			name: "colon-dash-default used when unset or empty",
+			in:   "value: ${FOO:-default}",
+			env:  map[string]string{"FOO": ""},
+			want: "value: default",
+		},
+		{
+			name: "colon-dash-default not used when set and non-empty",
+			in:   "value: ${FOO:-default}",
+			vars: map[string]string{"FOO": "bar"},
+			want: "value: bar",
+		},
+		{
+			name: "bare question errors only when unset",
+			in:   "value: ${FOO?required}",
+			env:  map[string]string{"FOO": ""},
+			want: "value: ",
+		},
+		{
+			name:    "colon-question errors when unset or empty",
+			in:      "value: ${FOO:?required}",
+			env:     map[string]string{"FOO": ""},
+			wantErr: true,
+		},
+		{
+			name: "vars take precedence over environment",
+			in:   "value: ${FOO}",
+			vars: map[string]string{"FOO": "from-vars"},
+			env:  map[string]string{"FOO": "from-env"},
+			want: "value: from-vars",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got, err := interpolateVariables([]byte(tt.in), tt.vars)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none (result: %s)", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// writeFixture writes content to name under dir and returns its path.
+func writeFixture(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestLoadResourceLogsWithOptions_DiamondReuse exercises two resource_logs entries in the same fixture, each
+// extending a different named resource in the same shared base file. base.yaml is legitimately visited twice along
+// sibling branches here, which is not a cycle.
+func TestLoadResourceLogsWithOptions_DiamondReuse(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "base.yaml", `
+resource_logs:
+  - name: service-a
+    attributes:
+      service.name: a
+    scope_logs:
+      - instrumentation_scope:
+          name: scope-a
+        logs:
+          - body: from-a
+  - name: service-b
+    attributes:
+      service.name: b
+    scope_logs:
+      - instrumentation_scope:
+          name: scope-b
+        logs:
+          - body: from-b
+`)
+	childPath := writeFixture(t, dir, "child.yaml", `
+resource_logs:
+  - extends:
+      file: base.yaml
+      resource: service-a
+    scope_logs:
+      - instrumentation_scope:
+          name: scope-a
+        logs:
+          - body: extra-a
+  - extends:
+      file: base.yaml
+      resource: service-b
+    scope_logs:
+      - instrumentation_scope:
+          name: scope-b
+        logs:
+          - body: extra-b
+`)
+
+	loaded, err := LoadResourceLogs(childPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(loaded.ResourceLogs) != 2 {
+		t.Fatalf("expected 2 resource logs, got %d", len(loaded.ResourceLogs))
+	}
+	// extends appends scope_logs (parent entries, then child's) rather than merging same-named scopes together,
+	// so each merged resource_log has two ScopeLogs entries here, one from the base and one from the child.
+	for _, rl := range loaded.ResourceLogs {
+		if len(rl.ScopeLogs) != 2 {
+			t.Fatalf("expected 2 scope_logs entries (base + child), got %+v", rl.ScopeLogs)
+		}
+	}
+}
+
+// TestLoadResourceLogsWithOptions_IncludeAndExtendsSameFile exercises an includes entry and a sibling extends of
+// the same file in one fixture, so shared.yaml is resolved once via includes and once via the extends lookup.
+func TestLoadResourceLogsWithOptions_IncludeAndExtendsSameFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "shared.yaml", `
+resource_logs:
+  - name: shared-service
+    attributes:
+      service.name: shared
+    scope_logs:
+      - instrumentation_scope:
+          name: shared-scope
+        logs:
+          - body: shared-log
+`)
+	path := writeFixture(t, dir, "fixture.yaml", `
+includes:
+  - shared.yaml
+resource_logs:
+  - extends:
+      file: shared.yaml
+      resource: shared-service
+    scope_logs:
+      - instrumentation_scope:
+          name: shared-scope
+        logs:
+          - body: extending-log
+`)
+
+	loaded, err := LoadResourceLogs(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(loaded.ResourceLogs) != 2 {
+		t.Fatalf("expected the included resource log plus the extending one, got %d", len(loaded.ResourceLogs))
+	}
+}
+
+func TestResolveFixture_DetectsActualCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "a.yaml", `
+includes:
+  - b.yaml
+resource_logs: []
+`)
+	path := writeFixture(t, dir, "b.yaml", `
+includes:
+  - a.yaml
+resource_logs: []
+`)
+
+	if _, err := LoadResourceLogs(path); err == nil {
+		t.Fatal("expected a cyclical include error, got none")
+	}
+}
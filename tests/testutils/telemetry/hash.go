@@ -0,0 +1,171 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"crypto/md5" // #nosec this is not for cryptographic purposes, and only used when UseLegacyMD5Hash is set
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"sort"
+	"time"
+)
+
+// UseLegacyMD5Hash reverts Log.Hash, Resource.Hash, and InstrumentationScope.Hash to their pre-canonicalization
+// behavior of md5-hashing a yaml.Marshal of the value. That form is not stable across Go map iteration order for
+// Attributes, so FlattenResourceLogs can fail to dedupe equivalent entries; leave this false unless a caller has
+// pinned hash values produced by the old implementation.
+var UseLegacyMD5Hash = false
+
+// Hash provides a hash determined by Log content, stable regardless of Go map iteration order. See
+// UseLegacyMD5Hash for the deprecated alternative.
+func (log Log) Hash() string {
+	if UseLegacyMD5Hash {
+		return legacyHash(log.String())
+	}
+	h := fnv.New64a()
+	writeField(h, "observed_timestamp", log.ObservedTimestamp)
+	writeField(h, "timestamp", log.Timestamp)
+	writeField(h, "body", log.Body)
+	writeField(h, "attributes", log.Attributes)
+	if log.Severity != nil {
+		writeField(h, "severity", int64(*log.Severity))
+	} else {
+		writeField(h, "severity", nil)
+	}
+	writeField(h, "severity_text", log.SeverityText)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// Hash provides a hash determined by Resource content, stable regardless of Go map iteration order. See
+// UseLegacyMD5Hash for the deprecated alternative.
+func (resource Resource) Hash() string {
+	if UseLegacyMD5Hash {
+		return legacyHash(resource.String())
+	}
+	h := fnv.New64a()
+	writeField(h, "attributes", resource.Attributes)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// Hash provides a hash determined by InstrumentationScope content, stable regardless of Go map iteration order.
+// See UseLegacyMD5Hash for the deprecated alternative.
+func (scope InstrumentationScope) Hash() string {
+	if UseLegacyMD5Hash {
+		return legacyHash(scope.String())
+	}
+	h := fnv.New64a()
+	writeField(h, "name", scope.Name)
+	writeField(h, "version", scope.Version)
+	writeField(h, "attributes", scope.Attributes)
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+func legacyHash(s string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(s))) // #nosec
+}
+
+// writeField writes a named field and its canonicalized value to h, in the fixed order callers invoke it, so that
+// two equal Log/Resource/InstrumentationScope values always produce identical hash input regardless of map
+// iteration order.
+func writeField(h hash.Hash64, name string, value any) {
+	writeToken(h, name)
+	writeValue(h, value)
+}
+
+func writeToken(h hash.Hash64, s string) {
+	_, _ = h.Write([]byte(s))
+	_, _ = h.Write([]byte{0})
+}
+
+func writeValue(h hash.Hash64, v any) {
+	switch value := v.(type) {
+	case nil:
+		writeToken(h, "<nil>")
+	case *map[string]any:
+		if value == nil {
+			writeToken(h, "<nil>")
+			return
+		}
+		writeAttributes(h, *value)
+	case map[string]any:
+		writeAttributes(h, value)
+	case map[interface{}]interface{}:
+		normalized := make(map[string]any, len(value))
+		for k, vv := range value {
+			normalized[fmt.Sprintf("%v", k)] = vv
+		}
+		writeAttributes(h, normalized)
+	case []any:
+		writeToken(h, fmt.Sprintf("[%d]", len(value)))
+		for _, item := range value {
+			writeValue(h, item)
+		}
+	case time.Time:
+		writeTime(h, value)
+	case string:
+		writeToken(h, "s:"+value)
+	case bool:
+		writeToken(h, fmt.Sprintf("b:%t", value))
+	case int:
+		writeNumber(h, float64(value))
+	case int32:
+		writeNumber(h, float64(value))
+	case int64:
+		writeNumber(h, float64(value))
+	case float32:
+		writeNumber(h, float64(value))
+	case float64:
+		writeNumber(h, value)
+	default:
+		writeToken(h, fmt.Sprintf("s:%v", value))
+	}
+}
+
+// writeAttributes writes an attribute map with its keys sorted lexicographically, so the hash does not depend on
+// Go's randomized map iteration order.
+func writeAttributes(h hash.Hash64, attrs map[string]any) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	writeToken(h, fmt.Sprintf("{%d}", len(keys)))
+	for _, k := range keys {
+		writeToken(h, k)
+		writeValue(h, attrs[k])
+	}
+}
+
+// writeTime renders t as RFC3339Nano in UTC, so the same instant hashes identically regardless of its original
+// location or sub-second precision.
+func writeTime(h hash.Hash64, t time.Time) {
+	if t.IsZero() {
+		writeToken(h, "<zero>")
+		return
+	}
+	writeToken(h, t.UTC().Format(time.RFC3339Nano))
+}
+
+// writeNumber normalizes int, int64, and float64 representations of the same integral value (1, int64(1), and 1.0
+// all hash identically) while preserving non-integral float precision. The "n:" tag keeps a number from hashing
+// identically to a string with the same digits, e.g. Attributes{"count": "1"} vs Attributes{"count": 1}.
+func writeNumber(h hash.Hash64, f float64) {
+	if f == float64(int64(f)) {
+		writeToken(h, fmt.Sprintf("n:%d", int64(f)))
+		return
+	}
+	writeToken(h, fmt.Sprintf("n:%g", f))
+}
@@ -0,0 +1,112 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import "testing"
+
+// buildAttributes returns a fresh map[string]any built by inserting keys in the given order, so two maps with the
+// same content but different insertion/iteration order can be compared.
+func buildAttributes(pairs ...any) map[string]any {
+	m := make(map[string]any, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		m[pairs[i].(string)] = pairs[i+1]
+	}
+	return m
+}
+
+func TestLogHash_StableAcrossAttributeOrder(t *testing.T) {
+	a := buildAttributes("alpha", "1", "beta", 2, "gamma", true)
+	b := buildAttributes("gamma", true, "alpha", "1", "beta", 2)
+
+	logA := Log{Body: "hello", Attributes: &a}
+	logB := Log{Body: "hello", Attributes: &b}
+
+	if logA.Hash() != logB.Hash() {
+		t.Fatalf("expected equal hashes regardless of attribute insertion order, got %s vs %s", logA.Hash(), logB.Hash())
+	}
+}
+
+func TestLogHash_DiffersOnDifferentContent(t *testing.T) {
+	a := buildAttributes("alpha", "1")
+	b := buildAttributes("alpha", "2")
+
+	logA := Log{Body: "hello", Attributes: &a}
+	logB := Log{Body: "hello", Attributes: &b}
+
+	if logA.Hash() == logB.Hash() {
+		t.Fatal("expected different hashes for different attribute values")
+	}
+}
+
+func TestLogHash_DistinguishesStringFromNumberWithSameDigits(t *testing.T) {
+	asString := buildAttributes("count", "1")
+	asNumber := buildAttributes("count", 1)
+
+	logString := Log{Body: "hello", Attributes: &asString}
+	logNumber := Log{Body: "hello", Attributes: &asNumber}
+
+	if logString.Hash() == logNumber.Hash() {
+		t.Fatal("expected a string attribute value to hash differently from a number with the same digits")
+	}
+}
+
+func TestLogHash_NormalizesEquivalentNumericTypes(t *testing.T) {
+	asInt := buildAttributes("count", int(1))
+	asInt64 := buildAttributes("count", int64(1))
+	asFloat := buildAttributes("count", float64(1))
+
+	logInt := Log{Attributes: &asInt}
+	logInt64 := Log{Attributes: &asInt64}
+	logFloat := Log{Attributes: &asFloat}
+
+	if logInt.Hash() != logInt64.Hash() || logInt.Hash() != logFloat.Hash() {
+		t.Fatalf("expected int, int64, and integral float64 attribute values to hash identically, got %s, %s, %s",
+			logInt.Hash(), logInt64.Hash(), logFloat.Hash())
+	}
+}
+
+func TestResourceHash_StableAcrossAttributeOrder(t *testing.T) {
+	a := buildAttributes("service.name", "a", "service.version", "1")
+	b := buildAttributes("service.version", "1", "service.name", "a")
+
+	resourceA := Resource{Attributes: &a}
+	resourceB := Resource{Attributes: &b}
+
+	if resourceA.Hash() != resourceB.Hash() {
+		t.Fatalf("expected equal hashes regardless of attribute insertion order, got %s vs %s", resourceA.Hash(), resourceB.Hash())
+	}
+}
+
+func TestInstrumentationScopeHash_StableAcrossAttributeOrder(t *testing.T) {
+	a := buildAttributes("x", "1", "y", "2")
+	b := buildAttributes("y", "2", "x", "1")
+
+	scopeA := InstrumentationScope{Name: "scope", Version: "1.0", Attributes: &a}
+	scopeB := InstrumentationScope{Name: "scope", Version: "1.0", Attributes: &b}
+
+	if scopeA.Hash() != scopeB.Hash() {
+		t.Fatalf("expected equal hashes regardless of attribute insertion order, got %s vs %s", scopeA.Hash(), scopeB.Hash())
+	}
+}
+
+func TestLegacyMD5Hash_StillAvailable(t *testing.T) {
+	UseLegacyMD5Hash = true
+	defer func() { UseLegacyMD5Hash = false }()
+
+	log := Log{Body: "hello"}
+	if got := log.Hash(); len(got) != 32 {
+		t.Fatalf("expected a 32-character md5 hex digest, got %q", got)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"reflect"
+
+	"gopkg.in/yaml.v2"
+)
+
+// InstrumentationScope identifies the name, version, and attributes of the instrumentation producing a ScopeLogs
+// item's Logs.
+type InstrumentationScope struct {
+	Name       string          `yaml:"name,omitempty"`
+	Version    string          `yaml:"version,omitempty"`
+	Attributes *map[string]any `yaml:"attributes,omitempty"`
+}
+
+func (scope InstrumentationScope) String() string {
+	out, err := yaml.Marshal(scope)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+// Equals confirms that Name, Version, and Attributes in the receiver InstrumentationScope are equal to toCompare.
+func (scope InstrumentationScope) Equals(toCompare InstrumentationScope) bool {
+	if scope.Name != toCompare.Name || scope.Version != toCompare.Version {
+		return false
+	}
+	if scope.Attributes == nil {
+		return toCompare.Attributes == nil
+	}
+	if toCompare.Attributes == nil {
+		return false
+	}
+	return reflect.DeepEqual(*scope.Attributes, *toCompare.Attributes)
+}
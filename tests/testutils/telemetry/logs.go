@@ -15,10 +15,7 @@
 package telemetry
 
 import (
-	"bytes"
-	"crypto/md5" // #nosec this is not for cryptographic purposes
 	"fmt"
-	"os"
 	"reflect"
 	"time"
 
@@ -28,15 +25,23 @@ import (
 	"github.com/signalfx/splunk-otel-collector/tests/internal/version"
 )
 
+// buildVersionPlaceholder is substituted with the running build's version by FillDefaultValues, so fixtures don't
+// need to be updated on every release.
+const buildVersionPlaceholder = "[build_version]"
+
 // ResourceLogs is a convenience type for testing helpers and assertions.
 // Analogous to pdata form, with the exception that InstrumentationScope.Logs items act as both parent log container
 // and records whose identity is based on differing attributes and other fields.
+// Use FromPlogLogs and ResourceLogs.ToPlogLogs to convert to and from plog.Logs.
 type ResourceLogs struct {
 	ResourceLogs []ResourceLog `yaml:"resource_logs"`
 }
 
 // ResourceLog is the top level log type for a given Resource (set of attributes) and its associated ScopeLogs.
 type ResourceLog struct {
+	// Name is a fixture-local alias that another fixture's extends.resource can reference. It is not part of the
+	// OTLP data model and has no bearing on Resource equality or hashing.
+	Name      string      `yaml:"name,omitempty"`
 	Resource  Resource    `yaml:",inline,omitempty"`
 	ScopeLogs []ScopeLogs `yaml:"scope_logs"`
 }
@@ -59,17 +64,28 @@ type Log struct {
 
 // LoadResourceLogs returns a ResourceLogs instance generated via parsing a valid yaml file at the provided path.
 func LoadResourceLogs(path string) (*ResourceLogs, error) {
-	logFile, err := os.Open(path)
+	return LoadResourceLogsWithOptions(path, LoadOptions{})
+}
+
+// LoadOptions configures optional behavior of LoadResourceLogsWithOptions.
+type LoadOptions struct {
+	// Variables resolves ${VAR}, ${VAR:-default}, and ${VAR:?error} interpolations in the fixture and any files it
+	// includes or extends, taking precedence over the process environment.
+	Variables map[string]string
+}
+
+// LoadResourceLogsWithOptions returns a ResourceLogs instance generated via parsing a valid yaml file at the
+// provided path, after resolving ${VAR} interpolations, top-level includes, and per-resource_log extends per opts.
+// See resolveFixture for the resolution semantics.
+func LoadResourceLogsWithOptions(path string, opts LoadOptions) (*ResourceLogs, error) {
+	by, err := resolveFixture(path, opts, map[string]bool{})
 	if err != nil {
 		return nil, err
 	}
-	defer logFile.Chdir()
 
-	buffer := new(bytes.Buffer)
-	if _, err = buffer.ReadFrom(logFile); err != nil {
-		return nil, err
+	if err = validateResourceLogsSchema(by); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
 	}
-	by := buffer.Bytes()
 
 	var loaded ResourceLogs
 	err = yaml.UnmarshalStrict(by, &loaded)
@@ -77,10 +93,6 @@ func LoadResourceLogs(path string) (*ResourceLogs, error) {
 		return nil, err
 	}
 	loaded.FillDefaultValues()
-	err = loaded.Validate() // in lieu of json/yaml schema adoption
-	if err != nil {
-		return nil, err
-	}
 	return &loaded, nil
 }
 
@@ -104,18 +116,6 @@ func (resourceLogs *ResourceLogs) FillDefaultValues() {
 	}
 }
 
-// Determines if all values in ResourceLogs item are valid
-func (resourceLogs ResourceLogs) Validate() error {
-	for _, rm := range resourceLogs.ResourceLogs {
-		for _, sls := range rm.ScopeLogs {
-			for range sls.Logs {
-				continue
-			}
-		}
-	}
-	return nil
-}
-
 func (log Log) String() string {
 	out, err := yaml.Marshal(log)
 	if err != nil {
@@ -124,11 +124,6 @@ func (log Log) String() string {
 	return string(out)
 }
 
-// Hash provides an md5 hash determined by Log content.
-func (log Log) Hash() string {
-	return fmt.Sprintf("%x", md5.Sum([]byte(log.String()))) // #nosec
-}
-
 // Equals confirms that all fields, defined or not, in receiver Log are equal to toCompare.
 func (log Log) Equals(toCompare Log) bool {
 	return log.equals(toCompare, true)
@@ -142,7 +137,9 @@ func (log Log) RelaxedEquals(toCompare Log) bool {
 
 // equals determines if receiver Log is equal to toCompare Log, relaxed if not strict
 func (log Log) equals(toCompare Log, strict bool) bool {
-	if log.Body != toCompare.Body && (strict || log.Body != nil) {
+	// log.Body may hold a map or slice (e.g. a nested-map fixture body), which a plain != would panic comparing, so
+	// it needs reflect.DeepEqual like Attributes below rather than ==.
+	if !reflect.DeepEqual(log.Body, toCompare.Body) && (strict || log.Body != nil) {
 		return false
 	}
 	if log.SeverityText != toCompare.SeverityText && (strict || log.SeverityText != "") {
@@ -258,59 +255,11 @@ func FlattenResourceLogs(resourceLogs ...ResourceLogs) ResourceLogs {
 // Log equivalence is based on RelaxedEquals() check: fields not in expected (e.g. unit, type, value, etc.)
 // are not compared to received, but all labels must match.
 // For better reliability, it's advised that both ResourceLogs items have been flattened by FlattenResourceLogs.
+// See Diff for a version that returns the missing items instead of a formatted error.
 func (resourceLogs ResourceLogs) ContainsAll(contains ResourceLogs) (bool, error) {
-	var missingResources []string
-	var missingInstrumentationLibraries []string
-	var missingLogs []string
-
-	for _, expectedResourceLog := range contains.ResourceLogs {
-		resourceMatched := false
-		for _, resourceLog := range resourceLogs.ResourceLogs {
-			if resourceLog.Resource.Equals(expectedResourceLog.Resource) {
-				resourceMatched = true
-				for _, expectedILM := range expectedResourceLog.ScopeLogs {
-					InstrumentationScopeMatched := false
-					for _, ilm := range resourceLog.ScopeLogs {
-						if ilm.Scope.Equals(expectedILM.Scope) {
-							InstrumentationScopeMatched = true
-							for _, expectedLog := range expectedILM.Logs {
-								logFound := false
-								for _, log := range ilm.Logs {
-									if expectedLog.RelaxedEquals(log) {
-										logFound = true
-									}
-								}
-								if !logFound {
-									missingLogs = append(missingLogs, expectedLog.String())
-								}
-							}
-							if len(missingLogs) != 0 {
-								return false, fmt.Errorf(
-									"%v doesn't contain all of %v.  Missing Logs: %s",
-									ilm.Logs, expectedILM.Logs, missingLogs)
-							}
-						}
-					}
-					if !InstrumentationScopeMatched {
-						missingInstrumentationLibraries = append(missingInstrumentationLibraries, expectedILM.Scope.String())
-					}
-				}
-				if len(missingInstrumentationLibraries) != 0 {
-					return false, fmt.Errorf(
-						"%v doesn't contain all of  %v.  Missing InstrumentationLibraries: %s",
-						resourceLog.ScopeLogs, expectedResourceLog.ScopeLogs, missingInstrumentationLibraries)
-				}
-			}
-		}
-		if !resourceMatched {
-			missingResources = append(missingResources, expectedResourceLog.Resource.String())
-		}
-	}
-	if len(missingResources) != 0 {
-		return false, fmt.Errorf(
-			"%v doesn't contain all of %v.  Missing resources: %s",
-			resourceLogs.ResourceLogs, contains.ResourceLogs, missingResources,
-		)
+	diff := resourceLogs.Diff(contains)
+	if diff.Empty() {
+		return true, nil
 	}
-	return true, nil
+	return false, fmt.Errorf("resource logs missing expected content:\n%s", diff.String())
 }
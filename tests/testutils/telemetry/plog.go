@@ -0,0 +1,174 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"fmt"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// FromPlogLogs converts a plog.Logs instance, e.g. one received from a collector sink in an integration test,
+// into the ResourceLogs fixture representation used by LoadResourceLogs, ContainsAll, and Diff, so the two can be
+// compared without manually walking the pdata structures. It is the inverse of ResourceLogs.ToPlogLogs.
+func FromPlogLogs(logs plog.Logs) ResourceLogs {
+	var resourceLogs ResourceLogs
+	prls := logs.ResourceLogs()
+	for i := 0; i < prls.Len(); i++ {
+		prl := prls.At(i)
+		resourceLogs.ResourceLogs = append(resourceLogs.ResourceLogs, resourceLogFromPlog(prl))
+	}
+	return resourceLogs
+}
+
+func resourceLogFromPlog(prl plog.ResourceLogs) ResourceLog {
+	rl := ResourceLog{Resource: resourceFromPcommon(prl.Resource().Attributes())}
+	pslices := prl.ScopeLogs()
+	for i := 0; i < pslices.Len(); i++ {
+		rl.ScopeLogs = append(rl.ScopeLogs, scopeLogsFromPlog(pslices.At(i)))
+	}
+	return rl
+}
+
+func scopeLogsFromPlog(psl plog.ScopeLogs) ScopeLogs {
+	sl := ScopeLogs{
+		Scope: InstrumentationScope{
+			Name:    psl.Scope().Name(),
+			Version: psl.Scope().Version(),
+		},
+	}
+	if attrs := psl.Scope().Attributes(); attrs.Len() > 0 {
+		raw := attrs.AsRaw()
+		sl.Scope.Attributes = &raw
+	}
+	records := psl.LogRecords()
+	for i := 0; i < records.Len(); i++ {
+		sl.Logs = append(sl.Logs, logFromPlog(records.At(i)))
+	}
+	return sl
+}
+
+func logFromPlog(pr plog.LogRecord) Log {
+	log := Log{
+		Body:         bodyFromPcommon(pr.Body()),
+		SeverityText: pr.SeverityText(),
+	}
+	if pr.ObservedTimestamp() != 0 {
+		log.ObservedTimestamp = pr.ObservedTimestamp().AsTime()
+	}
+	if pr.Timestamp() != 0 {
+		log.Timestamp = pr.Timestamp().AsTime()
+	}
+	if pr.SeverityNumber() != plog.SeverityNumberUnspecified {
+		severity := pr.SeverityNumber()
+		log.Severity = &severity
+	}
+	if attrs := pr.Attributes(); attrs.Len() > 0 {
+		raw := attrs.AsRaw()
+		log.Attributes = &raw
+	}
+	return log
+}
+
+func resourceFromPcommon(attrs pcommon.Map) Resource {
+	var resource Resource
+	if attrs.Len() > 0 {
+		raw := attrs.AsRaw()
+		resource.Attributes = &raw
+	}
+	return resource
+}
+
+func bodyFromPcommon(value pcommon.Value) any {
+	if value.Type() == pcommon.ValueTypeEmpty {
+		return nil
+	}
+	return value.AsRaw()
+}
+
+// normalizeAttributes converts any map[interface{}]interface{} values nested in attrs (as produced by
+// gopkg.in/yaml.v2 for a mapping assigned to an interface{}-typed field) into map[string]any, which is all that
+// pcommon.Map.FromRaw and pcommon.Value.FromRaw accept; anything else falls through to their "Invalid value type"
+// case. attrs itself is always already map[string]any since that's the Log/Resource/InstrumentationScope
+// Attributes field type, so only its values need normalizing.
+func normalizeAttributes(attrs map[string]any) map[string]any {
+	return normalizeYAMLValue(attrs).(map[string]any)
+}
+
+// ToPlogLogs converts the receiver ResourceLogs fixture into a plog.Logs instance, the inverse of FromPlogLogs.
+func (resourceLogs ResourceLogs) ToPlogLogs() (plog.Logs, error) {
+	logs := plog.NewLogs()
+	for _, rl := range resourceLogs.ResourceLogs {
+		prl := logs.ResourceLogs().AppendEmpty()
+		if rl.Resource.Attributes != nil {
+			if err := prl.Resource().Attributes().FromRaw(normalizeAttributes(*rl.Resource.Attributes)); err != nil {
+				return plog.Logs{}, fmt.Errorf("resource attributes: %w", err)
+			}
+		}
+		for _, sl := range rl.ScopeLogs {
+			psl := prl.ScopeLogs().AppendEmpty()
+			psl.Scope().SetName(sl.Scope.Name)
+			psl.Scope().SetVersion(sl.Scope.Version)
+			if sl.Scope.Attributes != nil {
+				if err := psl.Scope().Attributes().FromRaw(normalizeAttributes(*sl.Scope.Attributes)); err != nil {
+					return plog.Logs{}, fmt.Errorf("instrumentation scope attributes: %w", err)
+				}
+			}
+			for _, log := range sl.Logs {
+				pr := psl.LogRecords().AppendEmpty()
+				if log.Body != nil {
+					if err := pr.Body().FromRaw(normalizeYAMLValue(log.Body)); err != nil {
+						return plog.Logs{}, fmt.Errorf("log body: %w", err)
+					}
+				}
+				if log.Attributes != nil {
+					if err := pr.Attributes().FromRaw(normalizeAttributes(*log.Attributes)); err != nil {
+						return plog.Logs{}, fmt.Errorf("log attributes: %w", err)
+					}
+				}
+				if log.Severity != nil {
+					pr.SetSeverityNumber(*log.Severity)
+				}
+				pr.SetSeverityText(log.SeverityText)
+				if !log.ObservedTimestamp.IsZero() {
+					pr.SetObservedTimestamp(pcommon.NewTimestampFromTime(log.ObservedTimestamp))
+				}
+				if !log.Timestamp.IsZero() {
+					pr.SetTimestamp(pcommon.NewTimestampFromTime(log.Timestamp))
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+// AssertContainsAll fails the test if got, once converted via FromPlogLogs, doesn't contain all the resource logs,
+// instrumentation scopes, and log records defined in the fixture at expectedFixture, per the same semantics as
+// ContainsAll. It lets integration tests compare a collector sink's output directly against a fixture, without
+// manually converting plog.Logs first.
+func AssertContainsAll(t *testing.T, got plog.Logs, expectedFixture string) {
+	t.Helper()
+	expected, err := LoadResourceLogs(expectedFixture)
+	if err != nil {
+		t.Fatalf("failed loading fixture %s: %v", expectedFixture, err)
+		return
+	}
+	diff := FromPlogLogs(got).Diff(*expected)
+	if !diff.Empty() {
+		t.Errorf("%s: resource logs missing expected content:\n%s", expectedFixture, diff.String())
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// TestToPlogLogs_NestedMapBody constructs a fixture with a body and an attribute value holding a
+// map[interface{}]interface{}, as gopkg.in/yaml.v2 produces for a nested YAML mapping, and confirms ToPlogLogs
+// normalizes both before calling pcommon.Value.FromRaw.
+func TestToPlogLogs_NestedMapBody(t *testing.T) {
+	attrs := map[string]any{
+		"nested": map[interface{}]interface{}{
+			"inner": "value",
+			"count": 3,
+		},
+	}
+	resourceLogs := ResourceLogs{
+		ResourceLogs: []ResourceLog{
+			{
+				ScopeLogs: []ScopeLogs{
+					{
+						Logs: []Log{
+							{
+								Body:       map[interface{}]interface{}{"foo": "bar"},
+								Attributes: &attrs,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	logs, err := resourceLogs.ToPlogLogs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	body := record.Body().AsRaw()
+	bodyMap, ok := body.(map[string]any)
+	if !ok || bodyMap["foo"] != "bar" {
+		t.Fatalf("expected body to round-trip as a map, got %#v", body)
+	}
+
+	nested, ok := record.Attributes().AsRaw()["nested"].(map[string]any)
+	if !ok || nested["inner"] != "value" {
+		t.Fatalf("expected nested attribute to round-trip as a map, got %#v", record.Attributes().AsRaw())
+	}
+}
+
+// TestFromPlogLogs_ToPlogLogs_RoundTrip covers the full round trip through plog.Logs and back for a nested-map
+// body and flat resource and log attributes.
+func TestFromPlogLogs_ToPlogLogs_RoundTrip(t *testing.T) {
+	original := plog.NewLogs()
+	rl := original.ResourceLogs().AppendEmpty()
+	if err := rl.Resource().Attributes().FromRaw(map[string]any{"service.name": "svc"}); err != nil {
+		t.Fatal(err)
+	}
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	sl.Scope().SetVersion("1.0")
+
+	record := sl.LogRecords().AppendEmpty()
+	if err := record.Body().FromRaw(map[string]any{
+		"message": "hello",
+		"detail":  map[string]any{"retries": int64(2)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := record.Attributes().FromRaw(map[string]any{"http.status_code": int64(200)}); err != nil {
+		t.Fatal(err)
+	}
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.SetSeverityText("INFO")
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	record.SetTimestamp(pcommon.NewTimestampFromTime(now))
+
+	fixture := FromPlogLogs(original)
+	roundTripped, err := fixture.ToPlogLogs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	diff := FromPlogLogs(roundTripped).Diff(fixture)
+	if !diff.Empty() {
+		t.Fatalf("expected round trip to be lossless, got diff:\n%s", diff.String())
+	}
+}
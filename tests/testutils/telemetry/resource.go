@@ -0,0 +1,46 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"reflect"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Resource is the set of attributes identifying a given Resource across a ResourceLogs, ResourceMetrics, or
+// ResourceSpans item.
+type Resource struct {
+	Attributes *map[string]any `yaml:"attributes,omitempty"`
+}
+
+func (resource Resource) String() string {
+	out, err := yaml.Marshal(resource)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+// Equals confirms that all Attributes in the receiver Resource are equal to toCompare.
+func (resource Resource) Equals(toCompare Resource) bool {
+	if resource.Attributes == nil {
+		return toCompare.Attributes == nil
+	}
+	if toCompare.Attributes == nil {
+		return false
+	}
+	return reflect.DeepEqual(*resource.Attributes, *toCompare.Attributes)
+}
@@ -0,0 +1,112 @@
+// Copyright Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadResourceLogs_RejectsMalformedFixture(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "unknown top-level key",
+			content: `
+resource_logs: []
+not_a_real_key: true
+`,
+		},
+		{
+			name: "severity out of range",
+			content: `
+resource_logs:
+  - scope_logs:
+      - logs:
+          - severity: 99
+`,
+		},
+		{
+			name: "unknown log field",
+			content: `
+resource_logs:
+  - scope_logs:
+      - logs:
+          - not_a_real_field: true
+`,
+		},
+		{
+			name: "non-RFC3339 timestamp",
+			content: `
+resource_logs:
+  - scope_logs:
+      - logs:
+          - timestamp: "not-a-timestamp"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFixture(t, t.TempDir(), "fixture.yaml", tt.content)
+			if _, err := LoadResourceLogs(path); err == nil {
+				t.Fatal("expected schema validation to reject the fixture, got no error")
+			}
+		})
+	}
+}
+
+func TestLoadResourceLogs_AcceptsWellFormedFixture(t *testing.T) {
+	path := writeFixture(t, t.TempDir(), "fixture.yaml", `
+resource_logs:
+  - attributes:
+      service.name: my-service
+    scope_logs:
+      - instrumentation_scope:
+          name: my-scope
+          version: "1.0"
+        logs:
+          - timestamp: "2024-01-02T15:04:05Z"
+            body: hello
+            severity: 9
+            severity_text: INFO
+            attributes:
+              http.status_code: 200
+`)
+	loaded, err := LoadResourceLogs(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(loaded.ResourceLogs) != 1 {
+		t.Fatalf("expected 1 resource log, got %d", len(loaded.ResourceLogs))
+	}
+}
+
+func TestValidateResourceLogsSchema_ErrorIsPathQualified(t *testing.T) {
+	err := validateResourceLogsSchema([]byte(`
+resource_logs:
+  - scope_logs:
+      - logs:
+          - severity: 99
+`))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "resource_logs") {
+		t.Fatalf("expected error to be qualified with the offending path, got: %v", err)
+	}
+}